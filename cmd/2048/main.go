@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+	bm "github.com/charmbracelet/wish/bubbletea"
+	"github.com/charmbracelet/wish/logging"
+
+	"github.com/EthanEFung/2048/pkg/tui"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		serveCmd := flag.NewFlagSet("serve", flag.ExitOnError)
+		addr := serveCmd.String("addr", ":23234", "address to host the SSH server on")
+		hostKeyPath := serveCmd.String("host-key-path", ".ssh/2048_ed25519", "path to the server's SSH host key")
+		serveCmd.Parse(os.Args[2:])
+
+		runServer(*addr, *hostKeyPath)
+		return
+	}
+
+	runLocal()
+}
+
+/*
+runLocal plays a single local game in the current terminal, the
+original entry point before the serve subcommand existed.
+*/
+func runLocal() {
+	seed := flag.Int64("seed", time.Now().UnixNano(), "seed for the tile-placement RNG, set to replay a session deterministically. Ignored when resuming a saved game; pair with --new to start a fresh one")
+	size := flag.Int("size", 4, "board size, from 3 to 8. Ignored when resuming a saved game; pair with --new to start a fresh one")
+	goal := flag.Int("goal", 2048, "tile value needed to win. Ignored when resuming a saved game; pair with --new to start a fresh one")
+	new := flag.Bool("new", false, "start a fresh game instead of resuming the saved one, if any")
+	flag.Parse()
+
+	if *size < 3 || *size > 8 {
+		log.Fatalf("--size must be between 3 and 8, got %d", *size)
+	}
+	if *goal < 4 {
+		log.Fatalf("--goal must be at least 4, got %d", *goal)
+	}
+
+	var m *tui.Model
+	if !*new {
+		m, _ = tui.LoadState()
+	}
+	if m == nil {
+		fresh := tui.New(*seed, *size, *goal)
+		m = &fresh
+	}
+
+	program := tea.NewProgram(*m)
+	if err := program.Start(); err != nil {
+		log.Fatalf("Bootup Error: %v", err.Error())
+	}
+}
+
+/*
+runServer hosts the game over SSH using wish and the bubbletea
+middleware, giving each connecting user their own model and a shared
+lobby of who else is online.
+*/
+func runServer(addr, hostKeyPath string) {
+	srv, err := wish.NewServer(
+		wish.WithAddress(addr),
+		wish.WithHostKeyPath(hostKeyPath),
+		wish.WithMiddleware(
+			bm.Middleware(tui.TeaHandler),
+			logging.Middleware(),
+		),
+	)
+	if err != nil {
+		log.Fatalf("could not configure server: %v", err)
+	}
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
+
+	log.Printf("starting SSH server on %s", addr)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, ssh.ErrServerClosed) {
+			log.Fatalf("could not start server: %v", err)
+		}
+	}()
+
+	<-done
+	log.Println("stopping SSH server")
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Fatalln(err)
+	}
+}
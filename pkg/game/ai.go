@@ -0,0 +1,222 @@
+package game
+
+import "math"
+
+// AIDepth is how many plies the expectimax search looks ahead on a 4x4
+// board before falling back to the heuristic. 3-4 is deep enough to
+// play well while staying fast at that size.
+const AIDepth = 3
+
+/*
+DepthFor returns how many plies BestMove should search for a board of
+the given size. chanceValue branches on every empty cell times two
+spawns times up to four directions per ply, so a depth tuned for a 4x4
+board would stall a much bigger one; DepthFor trims a ply for every two
+extra rows/columns so autoplay stays responsive at size 8.
+*/
+func DepthFor(size int) int {
+	depth := AIDepth - (size-4)/2
+	if depth < 1 {
+		depth = 1
+	}
+	return depth
+}
+
+// heuristic weights tuned by feel rather than measurement; emptyWeight
+// dominates because running out of space is what actually ends games.
+const (
+	emptyWeight  = 2.7
+	monoWeight   = 1.0
+	smoothWeight = 0.1
+	cornerWeight = 1.0
+)
+
+/*
+cornerWeightAt biases the heuristic toward keeping the largest tiles in
+the top-left corner with a diagonal gradient rather than a "snake"
+table. It decreases by one per step away from that corner, so it
+scales to any board size instead of a fixed 4x4 table.
+*/
+func cornerWeightAt(size, x, y int) float64 {
+	return float64(size - x - y)
+}
+
+/*
+LogValue returns log2 of v, treating an empty cell as 0 rather than
+math.Log2(0) so the heuristics below don't have to special-case it at
+every call site.
+*/
+func LogValue(v int) float64 {
+	if v == 0 {
+		return 0
+	}
+	return math.Log2(float64(v))
+}
+
+/*
+monotonicity scores how consistently values increase or decrease along
+each row and column. Across all rows we total the pairwise increases
+and decreases separately and keep the smaller of the two, do the same
+across all columns, then negate the sum so a perfectly monotonic board
+scores highest.
+*/
+func monotonicity(grid [][]int) float64 {
+	var rowUp, rowDown float64
+	for y := range grid {
+		for x := 0; x < len(grid[y])-1; x++ {
+			cur, next := LogValue(grid[y][x]), LogValue(grid[y][x+1])
+			if cur > next {
+				rowDown += cur - next
+			} else {
+				rowUp += next - cur
+			}
+		}
+	}
+
+	var colUp, colDown float64
+	for x := range grid[0] {
+		for y := 0; y < len(grid)-1; y++ {
+			cur, next := LogValue(grid[y][x]), LogValue(grid[y+1][x])
+			if cur > next {
+				colDown += cur - next
+			} else {
+				colUp += next - cur
+			}
+		}
+	}
+
+	return -(math.Min(rowUp, rowDown) + math.Min(colUp, colDown))
+}
+
+/*
+smoothness scores how close adjacent non-empty tiles are in value,
+since neighbors that are close together merge more readily. It returns
+the negated sum of absolute differences between their log2 values, so
+a smoother board scores closer to zero.
+*/
+func smoothness(grid [][]int) float64 {
+	var total float64
+	for y := range grid {
+		for x := range grid[y] {
+			if grid[y][x] == 0 {
+				continue
+			}
+			v := LogValue(grid[y][x])
+			if x+1 < len(grid[y]) && grid[y][x+1] != 0 {
+				total -= math.Abs(v - LogValue(grid[y][x+1]))
+			}
+			if y+1 < len(grid) && grid[y+1][x] != 0 {
+				total -= math.Abs(v - LogValue(grid[y+1][x]))
+			}
+		}
+	}
+	return total
+}
+
+/*
+cornerScore rewards tiles that sit where cornerWeightAt is largest, so
+the AI prefers to build its biggest tile into a corner instead of
+stranding it in the middle of the board.
+*/
+func cornerScore(b *Board) float64 {
+	var total float64
+	for y := range b.grid {
+		for x := range b.grid[y] {
+			total += cornerWeightAt(b.size, x, y) * LogValue(b.grid[y][x])
+		}
+	}
+	return total
+}
+
+/*
+heuristic scores a leaf position for the expectimax search by blending
+free space, monotonicity, smoothness, and corner placement into a
+single value.
+*/
+func heuristic(b *Board) float64 {
+	return emptyWeight*float64(len(b.EmptyCells())) +
+		monoWeight*monotonicity(b.grid) +
+		smoothWeight*smoothness(b.grid) +
+		cornerWeight*cornerScore(b)
+}
+
+/*
+maxValue is the expectimax max-node evaluator: the player picks the
+direction that leads to the best chance-node value. It returns the
+heuristic once depth runs out or no direction changes the board.
+*/
+func maxValue(b *Board, depth int) float64 {
+	if depth == 0 {
+		return heuristic(b)
+	}
+
+	best := math.Inf(-1)
+	found := false
+	for _, dir := range directions {
+		moved, _ := b.TiltDry(dir)
+		if !moved {
+			continue
+		}
+		found = true
+		clone := b.Copy()
+		clone.Tilt(dir)
+		if v := chanceValue(clone, depth-1); v > best {
+			best = v
+		}
+	}
+	if !found {
+		return heuristic(b)
+	}
+	return best
+}
+
+/*
+chanceValue is the expectimax chance-node evaluator: it enumerates every
+empty cell and returns the probability-weighted average of the
+max-node values after spawning a "2" (weight 0.9) or a "4" (weight 0.1)
+there.
+*/
+func chanceValue(b *Board, depth int) float64 {
+	empty := b.EmptyCells()
+	if len(empty) == 0 {
+		return heuristic(b)
+	}
+
+	spawns := []struct {
+		value  int
+		weight float64
+	}{{2, 0.9}, {4, 0.1}}
+
+	var total float64
+	for _, cell := range empty {
+		for _, spawn := range spawns {
+			clone := b.Copy()
+			clone.grid[cell.Y][cell.X] = spawn.value
+			total += spawn.weight * maxValue(clone, depth)
+		}
+	}
+	return total / float64(len(empty))
+}
+
+/*
+BestMove runs the expectimax search from the current board and returns
+the direction with the highest expected value along with whether any
+direction was playable at all.
+*/
+func BestMove(b *Board, depth int) (best Direction, found bool) {
+	bestValue := math.Inf(-1)
+	for _, dir := range directions {
+		moved, _ := b.TiltDry(dir)
+		if !moved {
+			continue
+		}
+		clone := b.Copy()
+		clone.Tilt(dir)
+		if v := chanceValue(clone, depth-1); v > bestValue {
+			bestValue = v
+			best = dir
+			found = true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,57 @@
+package game
+
+import "testing"
+
+func TestBestMovePrefersAMergeOverNoMove(t *testing.T) {
+	b := NewBoard(4, 2048, 1)
+	b.grid = gridFrom([][]int{
+		{2, 2, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+	})
+
+	dir, found := BestMove(b, AIDepth)
+	if !found {
+		t.Fatalf("expected BestMove to find a playable direction")
+	}
+	moved, score := b.TiltDry(dir)
+	if !moved {
+		t.Fatalf("BestMove chose direction %v, which doesn't change the board", dir)
+	}
+	if score == 0 {
+		t.Fatalf("BestMove chose direction %v, which doesn't merge the two 2s", dir)
+	}
+}
+
+func TestBestMoveReportsNotFoundWhenNoMoveChangesTheBoard(t *testing.T) {
+	b := NewBoard(2, 2048, 1)
+	b.grid = gridFrom([][]int{
+		{2, 4},
+		{4, 2},
+	})
+
+	if _, found := BestMove(b, AIDepth); found {
+		t.Fatalf("expected BestMove to report found=false on a stuck board")
+	}
+}
+
+func TestDepthForShrinksOnLargerBoards(t *testing.T) {
+	cases := []struct {
+		size int
+		want int
+	}{
+		{3, AIDepth},
+		{4, AIDepth},
+		{6, AIDepth - 1},
+		{8, AIDepth - 2},
+	}
+	for _, c := range cases {
+		if got := DepthFor(c.size); got != c.want {
+			t.Fatalf("DepthFor(%d) = %d, want %d", c.size, got, c.want)
+		}
+	}
+	if DepthFor(8) < 1 {
+		t.Fatalf("DepthFor(8) = %d, should never drop below 1", DepthFor(8))
+	}
+}
@@ -0,0 +1,240 @@
+package game
+
+import "math/rand"
+
+// Position is a grid coordinate, returned by EmptyCells so callers can
+// place a tile without reaching into the board's internals.
+type Position struct {
+	X, Y int
+}
+
+/*
+Board represents a grid of values the player manipulates. size is the
+board's width and height (3-8), and goal is the tile value that wins
+the game. Board has no notion of a UI: it's driven headlessly by tests,
+benchmarks, and the AI's expectimax search, as well as by pkg/tui.
+*/
+type Board struct {
+	grid [][]int
+	size int
+	goal int
+	rng  *rand.Rand
+}
+
+/*
+NewBoard is a factory like function that returns an empty board of the
+given size with a target win tile of goal. seed drives the board's own
+RNG, so each board places tiles independently of any other board in
+the process.
+*/
+func NewBoard(size, goal int, seed int64) *Board {
+	grid := make([][]int, size)
+	for i := range grid {
+		grid[i] = make([]int, size)
+	}
+	return &Board{grid: grid, size: size, goal: goal, rng: rand.New(rand.NewSource(seed))}
+}
+
+// Size returns the board's width and height.
+func (b *Board) Size() int { return b.size }
+
+// Goal returns the tile value that wins the game.
+func (b *Board) Goal() int { return b.goal }
+
+// Grid returns the board's live grid; callers that need a snapshot
+// immune to later mutation should pass it through CloneGrid.
+func (b *Board) Grid() [][]int { return b.grid }
+
+// SetGrid replaces the board's grid wholesale, used to restore a
+// snapshot such as an undo/redo step or a loaded save.
+func (b *Board) SetGrid(grid [][]int) { b.grid = grid }
+
+/*
+Tilt manipulates the values of the grid along dir. It returns the score
+earned from merges made during the tilt, i.e. the sum of the resulting
+values of every merged pair.
+*/
+func (b *Board) Tilt(dir Direction) (score int) {
+	grid := b.grid
+	size := b.size
+	for line := 0; line < size; line++ {
+		// per line, we will take all the values double the matched
+		a, bi := 0, 0
+		for a < size && bi < size {
+			// first find the first non zero a
+			for a < size {
+				x, y := position(dir, size, line, a)
+				if grid[y][x] != 0 {
+					break
+				}
+				a++
+			}
+			if a >= size {
+				// since there is no more, we allow the
+				// loop to naturally exit
+				continue
+			}
+			// now we find the first non zero neighbor
+			bi = a + 1
+			for bi < size {
+				x, y := position(dir, size, line, bi)
+				if grid[y][x] != 0 {
+					break
+				}
+				bi++
+			}
+			if bi >= size {
+				// since there is no more, we allow the
+				// loop to naturally exit
+				continue
+			}
+			// otherwise we should have a bi with a non zero
+			ax, ay := position(dir, size, line, a)
+			bx, by := position(dir, size, line, bi)
+			if grid[ay][ax] == grid[by][bx] {
+				grid[ay][ax] = 0
+				grid[by][bx] *= 2
+				score += grid[by][bx]
+				// the merged cell can't merge again this tilt,
+				// so jump past it rather than reconsidering it.
+				a = bi + 1
+			} else {
+				a = bi
+			}
+		}
+
+		// per line we will then shift all the values towards the
+		// edge the tiles slide toward.
+		a = 0
+		for i := 0; i < size-1; i++ {
+			ax, ay := position(dir, size, line, a)
+			if grid[ay][ax] == 0 {
+				for bi := a + 1; bi < size; bi++ {
+					bx, by := position(dir, size, line, bi)
+					if grid[by][bx] != 0 {
+						grid[ay][ax], grid[by][bx] = grid[by][bx], grid[ay][ax]
+						break
+					}
+				}
+			}
+			a++
+		}
+	}
+	return score
+}
+
+/*
+Copy returns a copy of the board with its own deep-copied grid, so the
+AI and TiltDry can evaluate moves without mutating live state. The
+copy shares the original's rng.
+*/
+func (b *Board) Copy() *Board {
+	return &Board{
+		grid: CloneGrid(b.grid),
+		size: b.size,
+		goal: b.goal,
+		rng:  b.rng,
+	}
+}
+
+/*
+CloneGrid returns a deep copy of a grid, used anywhere a snapshot needs
+to survive later mutation of the original, such as move history and
+Board.Copy.
+*/
+func CloneGrid(grid [][]int) [][]int {
+	clone := make([][]int, len(grid))
+	for y := range grid {
+		row := make([]int, len(grid[y]))
+		copy(row, grid[y])
+		clone[y] = row
+	}
+	return clone
+}
+
+// EmptyCells returns every coordinate on the grid that is currently
+// unoccupied.
+func (b *Board) EmptyCells() []Position {
+	cells := []Position{}
+	for y := range b.grid {
+		for x := range b.grid[y] {
+			if b.grid[y][x] == 0 {
+				cells = append(cells, Position{x, y})
+			}
+		}
+	}
+	return cells
+}
+
+/*
+TiltDry simulates a tilt against a detached copy of the board, leaving
+the receiver untouched. It reports whether the tilt would change the
+grid, which lets the AI weigh a move without mutating live state.
+*/
+func (b *Board) TiltDry(dir Direction) (moved bool, score int) {
+	prev := b.grid
+	clone := b.Copy()
+	score = clone.Tilt(dir)
+	moved = clone.Changed(prev)
+	return moved, score
+}
+
+/*
+Place randomly sets a "2" in an available cell of the grid, drawing
+from the board's own rng so spawns are reproducible across a run and
+independent of any other board in the process.
+*/
+func (b *Board) Place() {
+	options := b.EmptyCells()
+	item := options[b.rng.Intn(len(options))]
+	b.grid[item.Y][item.X] = 2
+}
+
+// Changed checks if any of the current grid values differ from a
+// previous grid passed as an argument.
+func (b *Board) Changed(prev [][]int) bool {
+	for y := range b.grid {
+		for x := range b.grid[y] {
+			if b.grid[y][x] != prev[y][x] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// directions enumerates all four tilt directions, used anywhere a
+// board needs to be checked or searched exhaustively.
+var directions = []Direction{Up, Right, Down, Left}
+
+// GameOver reports whether no cell is free and no direction would
+// change the grid, i.e. the player has no moves left.
+func (b *Board) GameOver() bool {
+	if len(b.EmptyCells()) > 0 {
+		return false
+	}
+	for _, dir := range directions {
+		if moved, _ := b.TiltDry(dir); moved {
+			return false
+		}
+	}
+	return true
+}
+
+// Won reports whether a tile has reached goal.
+func (b *Board) Won() bool {
+	return b.HighestTile() >= b.goal
+}
+
+// HighestTile returns the largest value currently on the grid.
+func (b *Board) HighestTile() int {
+	max := 0
+	for _, row := range b.grid {
+		for _, v := range row {
+			if v > max {
+				max = v
+			}
+		}
+	}
+	return max
+}
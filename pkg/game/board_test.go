@@ -0,0 +1,222 @@
+package game
+
+import (
+	"bytes"
+	"testing"
+)
+
+func gridFrom(rows [][]int) [][]int {
+	return CloneGrid(rows)
+}
+
+func TestTiltLeftMerges(t *testing.T) {
+	b := NewBoard(4, 2048, 1)
+	b.grid = gridFrom([][]int{
+		{2, 2, 4, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+	})
+
+	score := b.Tilt(Left)
+
+	want := [][]int{
+		{4, 4, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+	}
+	for y := range want {
+		for x := range want[y] {
+			if b.grid[y][x] != want[y][x] {
+				t.Fatalf("grid mismatch at (%d,%d): got %d want %d", x, y, b.grid[y][x], want[y][x])
+			}
+		}
+	}
+	if score != 4 {
+		t.Fatalf("score = %d, want 4", score)
+	}
+}
+
+func TestTiltDirections(t *testing.T) {
+	// Every case is a size-by-size grid, so len(c.in) is unambiguously
+	// the board size rather than "however many rows this line needed".
+	cases := []struct {
+		dir  Direction
+		in   [][]int
+		want [][]int
+	}{
+		{
+			Right,
+			[][]int{
+				{2, 2, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			[][]int{
+				{0, 0, 0, 4},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+		},
+		{
+			Up,
+			[][]int{
+				{0, 0, 0, 0},
+				{2, 0, 0, 0},
+				{2, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			[][]int{
+				{4, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+		},
+		{
+			Down,
+			[][]int{
+				{0, 0, 0, 0},
+				{2, 0, 0, 0},
+				{2, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			[][]int{
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{4, 0, 0, 0},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		size := len(c.in)
+		b := NewBoard(size, 2048, 1)
+		b.grid = gridFrom(c.in)
+		b.Tilt(c.dir)
+		for y := range c.want {
+			for x := range c.want[y] {
+				if b.grid[y][x] != c.want[y][x] {
+					t.Fatalf("dir %v: grid mismatch at (%d,%d): got %d want %d", c.dir, x, y, b.grid[y][x], c.want[y][x])
+				}
+			}
+		}
+	}
+}
+
+func TestTiltLeftMultiMerge(t *testing.T) {
+	cases := []struct {
+		in    []int
+		want  []int
+		score int
+	}{
+		// Each pair merges independently; a tile can't merge twice in
+		// one tilt, so four equal tiles become two pairs, not one.
+		{[]int{2, 2, 2, 2}, []int{4, 4, 0, 0}, 8},
+		{[]int{2, 2, 2, 0}, []int{4, 2, 0, 0}, 4},
+	}
+
+	for _, c := range cases {
+		b := NewBoard(4, 2048, 1)
+		b.grid = gridFrom([][]int{c.in, {0, 0, 0, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}})
+
+		score := b.Tilt(Left)
+
+		for x := range c.want {
+			if b.grid[0][x] != c.want[x] {
+				t.Fatalf("row %v: grid mismatch at (%d,0): got %d want %d", c.in, x, b.grid[0][x], c.want[x])
+			}
+		}
+		if score != c.score {
+			t.Fatalf("row %v: score = %d, want %d", c.in, score, c.score)
+		}
+	}
+}
+
+func TestTiltDryLeavesBoardUnchanged(t *testing.T) {
+	b := NewBoard(4, 2048, 1)
+	b.grid = gridFrom([][]int{
+		{2, 2, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+	})
+	before := CloneGrid(b.grid)
+
+	moved, score := b.TiltDry(Left)
+	if !moved {
+		t.Fatalf("TiltDry reported no move for a mergeable board")
+	}
+	if score != 4 {
+		t.Fatalf("score = %d, want 4", score)
+	}
+	for y := range before {
+		for x := range before[y] {
+			if b.grid[y][x] != before[y][x] {
+				t.Fatalf("TiltDry mutated the board at (%d,%d)", x, y)
+			}
+		}
+	}
+}
+
+func TestGameOverDetectsNoMoves(t *testing.T) {
+	b := NewBoard(2, 2048, 1)
+	b.grid = gridFrom([][]int{
+		{2, 4},
+		{4, 2},
+	})
+	if !b.GameOver() {
+		t.Fatalf("expected GameOver to report true for a full, unmergeable board")
+	}
+}
+
+func TestWon(t *testing.T) {
+	b := NewBoard(4, 8, 1)
+	b.grid = gridFrom([][]int{
+		{8, 0, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+	})
+	if !b.Won() {
+		t.Fatalf("expected Won to report true once the goal tile is reached")
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	b := NewBoard(4, 2048, 42)
+	b.grid = gridFrom([][]int{
+		{2, 4, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+		{0, 0, 0, 0},
+	})
+
+	var buf bytes.Buffer
+	if err := b.Save(&buf, 10, 3, 42); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	loaded := NewBoard(4, 2048, 0)
+	score, moves, seed, err := loaded.Load(&buf)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if score != 10 || moves != 3 || seed != 42 {
+		t.Fatalf("Load returned (%d, %d, %d), want (10, 3, 42)", score, moves, seed)
+	}
+	if loaded.size != 4 || loaded.goal != 2048 {
+		t.Fatalf("Load restored size=%d goal=%d, want 4, 2048", loaded.size, loaded.goal)
+	}
+	for y := range b.grid {
+		for x := range b.grid[y] {
+			if loaded.grid[y][x] != b.grid[y][x] {
+				t.Fatalf("grid mismatch at (%d,%d) after round trip", x, y)
+			}
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package game
+
+// Direction identifies which way the board is tilted. Each direction
+// defines an ordering of "lines" (rows or columns) and, within each
+// line, an ordering of steps from the edge the tiles slide toward.
+type Direction int
+
+const (
+	Up Direction = iota
+	Right
+	Down
+	Left
+)
+
+/*
+position maps a (direction, line, step) triple to a grid coordinate, in
+an N-line board of the given size. line selects which row or column is
+being tilted; step counts from 0 at the edge tiles slide toward, up to
+size-1 at the far edge. This replaces the old linked-list "face": a
+direction and size are enough to compute any cell in O(1), so there's
+nothing to build or store per board.
+*/
+func position(dir Direction, size, line, step int) (x, y int) {
+	switch dir {
+	case Up:
+		return line, step
+	case Down:
+		return line, size - 1 - step
+	case Left:
+		return step, line
+	case Right:
+		return size - 1 - step, line
+	}
+	return 0, 0
+}
@@ -0,0 +1,51 @@
+package game
+
+import (
+	"encoding/json"
+	"io"
+)
+
+/*
+state is the on-disk shape written by Save and read by Load: the grid
+plus the surrounding session details needed to resume it exactly.
+*/
+type state struct {
+	Grid  [][]int `json:"grid"`
+	Size  int     `json:"size"`
+	Goal  int     `json:"goal"`
+	Score int     `json:"score"`
+	Moves int     `json:"moves"`
+	Seed  int64   `json:"seed"`
+}
+
+/*
+Save writes the board's grid, size, and goal along with the score,
+move count, and RNG seed needed to resume the session. The last three
+are arguments rather than board fields, so that the wire format can be
+tested independently of how the file is named or located.
+*/
+func (b *Board) Save(w io.Writer, score, moves int, seed int64) error {
+	return json.NewEncoder(w).Encode(state{
+		Grid:  b.grid,
+		Size:  b.size,
+		Goal:  b.goal,
+		Score: score,
+		Moves: moves,
+		Seed:  seed,
+	})
+}
+
+/*
+Load reads a state written by Save, rebuilding the receiver for the
+saved size and goal and restoring its grid, then returns the score,
+move count, and seed that went with it.
+*/
+func (b *Board) Load(r io.Reader) (score, moves int, seed int64, err error) {
+	var s state
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return 0, 0, 0, err
+	}
+	*b = *NewBoard(s.Size, s.Goal, s.Seed)
+	b.grid = s.Grid
+	return s.Score, s.Moves, s.Seed, nil
+}
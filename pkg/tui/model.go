@@ -0,0 +1,234 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/EthanEFung/2048/pkg/game"
+)
+
+// aiTickMsg drives autoplay: each tick lets the AI make one move and
+// schedules the next tick so the UI has time to render between moves.
+type aiTickMsg struct{}
+
+/*
+aiTick is a tea.Cmd that fires an aiTickMsg after a short delay.
+*/
+func aiTick() tea.Cmd {
+	return tea.Tick(150*time.Millisecond, func(time.Time) tea.Msg {
+		return aiTickMsg{}
+	})
+}
+
+/*
+historyEntry is a single undo step: the grid, score delta, and
+finished/won flags as they were immediately before the move it
+represents, so undo can restore them exactly.
+*/
+type historyEntry struct {
+	grid     [][]int
+	score    int
+	finished bool
+	won      bool
+}
+
+// Model is the bubbletea model for a single local or SSH-served game.
+type Model struct {
+	board        *game.Board
+	autoplay     bool
+	movesHistory []historyEntry
+	redoHistory  []historyEntry
+	score        int
+	moves        int
+	seed         int64
+	start        time.Time
+	finished     bool
+	won          bool
+	leaderboard  []scoreEntry
+	width        int
+	height       int
+	// persistent is false for games served over SSH, so one player's
+	// state.json/scores.json on the host doesn't clobber another's.
+	persistent bool
+}
+
+/*
+New is a factory like function that will create a board and place 2 2s
+upon it, and return the model with the created board.
+*/
+func New(seed int64, size, goal int) Model {
+	board := game.NewBoard(size, goal, seed)
+	board.Place()
+	board.Place()
+	return Model{
+		board:      board,
+		seed:       seed,
+		start:      time.Now(),
+		persistent: true,
+	}
+}
+
+/*
+applyMove tilts the board along dir and, if the tilt actually changed
+the grid, spawns a new tile and pushes the pre-move grid plus the
+move's score onto movesHistory so it can be undone. It clears
+redoHistory, since making a new move invalidates whatever was undone
+before it.
+*/
+func (m *Model) applyMove(dir game.Direction) {
+	prev := game.CloneGrid(m.board.Grid())
+	prevFinished, prevWon := m.finished, m.won
+	delta := m.board.Tilt(dir)
+	if !m.board.Changed(prev) {
+		return
+	}
+	m.board.Place()
+	m.movesHistory = append(m.movesHistory, historyEntry{grid: prev, score: delta, finished: prevFinished, won: prevWon})
+	m.redoHistory = nil
+	m.score += delta
+	m.moves++
+
+	if !m.finished && m.board.Won() {
+		m.won = true
+		m.finish()
+	} else if !m.finished && m.board.GameOver() {
+		m.finish()
+	}
+	m.persist()
+}
+
+/*
+finish marks the game as over and, for a persistent game, records it on
+the leaderboard.
+*/
+func (m *Model) finish() {
+	m.finished = true
+	if !m.persistent {
+		return
+	}
+	entry := scoreEntry{
+		HighestTile: m.board.HighestTile(),
+		Score:       m.score,
+		Duration:    time.Since(m.start),
+	}
+	if entries, err := recordScore(entry); err == nil {
+		m.leaderboard = entries
+	}
+}
+
+/*
+newGame resets the receiver to a freshly dealt board under a new seed,
+used once the player starts again after a game over.
+*/
+func (m *Model) newGame() {
+	size, goal := m.board.Size(), m.board.Goal()
+	persistent := m.persistent
+	seed := time.Now().UnixNano()
+	*m = New(seed, size, goal)
+	m.persistent = persistent
+	m.persist()
+}
+
+/*
+undo pops the most recent move off movesHistory, restores the grid,
+score, and finished/won flags to how they looked before that move, and
+pushes the reverted state onto redoHistory so redo can restore it.
+*/
+func (m *Model) undo() {
+	if len(m.movesHistory) == 0 {
+		return
+	}
+	last := m.movesHistory[len(m.movesHistory)-1]
+	m.movesHistory = m.movesHistory[:len(m.movesHistory)-1]
+
+	m.redoHistory = append(m.redoHistory, historyEntry{
+		grid:     game.CloneGrid(m.board.Grid()),
+		score:    last.score,
+		finished: m.finished,
+		won:      m.won,
+	})
+	m.board.SetGrid(last.grid)
+	m.score -= last.score
+	m.moves--
+	m.finished = last.finished
+	m.won = last.won
+	m.persist()
+}
+
+/*
+redo pops the most recently undone move off redoHistory and replays it,
+restoring the grid, score, and finished/won flags it left behind.
+*/
+func (m *Model) redo() {
+	if len(m.redoHistory) == 0 {
+		return
+	}
+	last := m.redoHistory[len(m.redoHistory)-1]
+	m.redoHistory = m.redoHistory[:len(m.redoHistory)-1]
+
+	m.movesHistory = append(m.movesHistory, historyEntry{
+		grid:     game.CloneGrid(m.board.Grid()),
+		score:    last.score,
+		finished: m.finished,
+		won:      m.won,
+	})
+	m.board.SetGrid(last.grid)
+	m.score += last.score
+	m.moves++
+	m.finished = last.finished
+	m.won = last.won
+	m.persist()
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// read the event
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		case "a":
+			m.autoplay = !m.autoplay
+			if m.autoplay {
+				return m, aiTick()
+			}
+		case "u":
+			m.undo()
+		case "ctrl+r":
+			m.redo()
+		case "n":
+			if m.finished {
+				m.newGame()
+			}
+		case "left", "h":
+			m.applyMove(game.Left)
+		case "up", "k":
+			m.applyMove(game.Up)
+		case "right", "l":
+			m.applyMove(game.Right)
+		case "down", "j":
+			m.applyMove(game.Down)
+		}
+	case aiTickMsg:
+		if !m.autoplay {
+			return m, nil
+		}
+		dir, found := game.BestMove(m.board, game.DepthFor(m.board.Size()))
+		if !found {
+			m.autoplay = false
+			return m, nil
+		}
+		m.applyMove(dir)
+		return m, aiTick()
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	}
+
+	return m, nil
+}
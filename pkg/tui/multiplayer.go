@@ -0,0 +1,216 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/ssh"
+	"github.com/charmbracelet/wish"
+
+	"github.com/EthanEFung/2048/pkg/game"
+)
+
+// ServerSize and ServerGoal are fixed for every game hosted by serve;
+// --size/--goal only apply to the local single-player entry point.
+const (
+	ServerSize = 4
+	ServerGoal = 2048
+)
+
+/*
+lobby tracks players currently connected to the SSH server, so new
+arrivals can list who's online and spectators can subscribe to a
+player's live grid.
+*/
+type lobby struct {
+	mu       sync.Mutex
+	players  map[string]bool
+	watchers map[string][]chan [][]int
+}
+
+var gameLobby = &lobby{
+	players:  make(map[string]bool),
+	watchers: make(map[string][]chan [][]int),
+}
+
+/* join registers user as an active player. */
+func (l *lobby) join(user string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.players[user] = true
+}
+
+/* leave removes user and closes out any spectators watching them. */
+func (l *lobby) leave(user string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.players, user)
+	for _, ch := range l.watchers[user] {
+		close(ch)
+	}
+	delete(l.watchers, user)
+}
+
+/* names lists the currently connected players, sorted for a stable lobby display. */
+func (l *lobby) names() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	names := make([]string, 0, len(l.players))
+	for name := range l.players {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+watch subscribes to user's grid snapshots, returning the channel to
+receive them on and whether user is actually connected.
+*/
+func (l *lobby) watch(user string) (chan [][]int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.players[user] {
+		return nil, false
+	}
+	ch := make(chan [][]int, 1)
+	l.watchers[user] = append(l.watchers[user], ch)
+	return ch, true
+}
+
+/*
+broadcast pushes grid to everyone watching user. A spectator that isn't
+keeping up has its update dropped rather than blocking the game.
+*/
+func (l *lobby) broadcast(user string, grid [][]int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, ch := range l.watchers[user] {
+		select {
+		case ch <- grid:
+		default:
+		}
+	}
+}
+
+/*
+PlayerModel wraps Model for a connected SSH player: every Update also
+broadcasts the resulting grid to the player's spectators.
+*/
+type PlayerModel struct {
+	Model
+	user string
+}
+
+func (m PlayerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	updated, cmd := m.Model.Update(msg)
+	m.Model = updated.(Model)
+	gameLobby.broadcast(m.user, game.CloneGrid(m.Model.board.Grid()))
+	return m, cmd
+}
+
+func (m PlayerModel) View() string {
+	return m.Model.View() + fmt.Sprintf("\nPlayers online: %s\n", strings.Join(gameLobby.names(), ", "))
+}
+
+// snapshotMsg carries a spectated player's grid after one of their moves.
+type snapshotMsg [][]int
+
+/*
+waitForSnapshot is a tea.Cmd that blocks for the next grid pushed onto
+feed, translating a lobby broadcast into a bubbletea message.
+*/
+func waitForSnapshot(feed <-chan [][]int) tea.Cmd {
+	return func() tea.Msg {
+		grid, ok := <-feed
+		if !ok {
+			return tea.Quit()
+		}
+		return snapshotMsg(grid)
+	}
+}
+
+/*
+SpectatorModel renders another player's board read-only, updating as
+snapshotMsg events arrive from the lobby.
+*/
+type SpectatorModel struct {
+	grid   [][]int
+	goal   int
+	target string
+	feed   <-chan [][]int
+}
+
+func (m SpectatorModel) Init() tea.Cmd {
+	return waitForSnapshot(m.feed)
+}
+
+func (m SpectatorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case snapshotMsg:
+		m.grid = msg
+		return m, waitForSnapshot(m.feed)
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m SpectatorModel) View() string {
+	rows := make([]string, len(m.grid))
+	for y, row := range m.grid {
+		cells := make([]string, len(row))
+		for x, value := range row {
+			text := ""
+			if value > 0 {
+				text = strconv.Itoa(value)
+			}
+			cells[x] = valueStyle(value, m.goal).Width(minCellWidth).Align(lipgloss.Center).Render(text)
+		}
+		rows[y] = lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+	}
+	grid := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	return fmt.Sprintf("Spectating %s (q to quit)\n\n%s\n", m.target, grid)
+}
+
+/*
+TeaHandler is the bubbletea middleware's per-session entry point: it
+hands a connecting user either their own PlayerModel, or a
+SpectatorModel if they ran `ssh host watch <user>`.
+*/
+func TeaHandler(s ssh.Session) (tea.Model, []tea.ProgramOption) {
+	if _, _, active := s.Pty(); !active {
+		wish.Fatalln(s, "no active terminal, skipping")
+		return nil, nil
+	}
+
+	user := s.User()
+	if cmd := s.Command(); len(cmd) > 1 && cmd[0] == "watch" {
+		target := cmd[1]
+		feed, ok := gameLobby.watch(target)
+		if !ok {
+			wish.Fatalln(s, fmt.Sprintf("no player named %q is connected", target))
+			return nil, nil
+		}
+		return SpectatorModel{goal: ServerGoal, target: target, feed: feed}, []tea.ProgramOption{tea.WithAltScreen()}
+	}
+
+	gameLobby.join(user)
+	go func() {
+		<-s.Context().Done()
+		gameLobby.leave(user)
+	}()
+
+	fresh := New(time.Now().UnixNano(), ServerSize, ServerGoal)
+	fresh.persistent = false
+	return PlayerModel{Model: fresh, user: user}, []tea.ProgramOption{tea.WithAltScreen()}
+}
@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/EthanEFung/2048/pkg/game"
+)
+
+// leaderboardSize caps how many finished games scores.json remembers.
+const leaderboardSize = 10
+
+/*
+configDir returns the directory state.json and scores.json live in,
+creating it if necessary.
+*/
+func configDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	dir = filepath.Join(dir, "2048")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+/*
+LoadState auto-loads state.json on startup so a session survives a
+quit. The bool reports whether a saved session was found.
+*/
+func LoadState() (*Model, bool) {
+	dir, err := configDir()
+	if err != nil {
+		return nil, false
+	}
+	f, err := os.Open(filepath.Join(dir, "state.json"))
+	if err != nil {
+		return nil, false
+	}
+	defer f.Close()
+
+	b := game.NewBoard(4, 2048, 0)
+	score, moves, seed, err := b.Load(f)
+	if err != nil {
+		return nil, false
+	}
+	return &Model{board: b, score: score, moves: moves, seed: seed, start: time.Now(), persistent: true}, true
+}
+
+/*
+scoreEntry is one finished game on the leaderboard.
+*/
+type scoreEntry struct {
+	HighestTile int           `json:"highest_tile"`
+	Score       int           `json:"score"`
+	Duration    time.Duration `json:"duration"`
+}
+
+/*
+loadLeaderboard reads scores.json, returning nil if it doesn't exist
+yet.
+*/
+func loadLeaderboard() ([]scoreEntry, error) {
+	dir, err := configDir()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(dir, "scores.json"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []scoreEntry
+	if err := json.NewDecoder(f).Decode(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+/*
+saveLeaderboard overwrites scores.json with entries.
+*/
+func saveLeaderboard(entries []scoreEntry) error {
+	dir, err := configDir()
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(dir, "scores.json"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(entries)
+}
+
+/*
+recordScore appends a finished game to the leaderboard and keeps only
+the top leaderboardSize entries by score.
+*/
+func recordScore(entry scoreEntry) ([]scoreEntry, error) {
+	entries, err := loadLeaderboard()
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, entry)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Score > entries[j].Score
+	})
+	if len(entries) > leaderboardSize {
+		entries = entries[:leaderboardSize]
+	}
+	if err := saveLeaderboard(entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+/*
+persist writes the current game to state.json so it survives a quit.
+Save failures are non-fatal: the game just won't resume next launch.
+*/
+func (m *Model) persist() {
+	if !m.persistent {
+		return
+	}
+	dir, err := configDir()
+	if err != nil {
+		return
+	}
+	f, err := os.Create(filepath.Join(dir, "state.json"))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	m.board.Save(f, m.score, m.moves, m.seed)
+}
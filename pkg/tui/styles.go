@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	colorful "github.com/lucasb-eyer/go-colorful"
+
+	"github.com/EthanEFung/2048/pkg/game"
+)
+
+var baseStyle = lipgloss.NewStyle().
+	Bold(true).
+	Underline(true)
+
+/* cellStyle is a utility function that creates a lipgloss style */
+func cellStyle(hex string) lipgloss.Style {
+	return baseStyle.Copy().Foreground(lipgloss.Color(hex))
+}
+
+// emptyCellColor styles empty cells; colorLow/colorHigh are the gradient
+// anchors valueStyle blends between for every other tile, replacing the old fixed 12-entry table so
+// any goal tile gets a smooth ramp instead of running off the end.
+const emptyCellColor = "#eeeeee"
+
+var (
+	colorLow  = hexColor("#eee4da")
+	colorHigh = hexColor("#edc22e")
+)
+
+func hexColor(hex string) colorful.Color {
+	c, _ := colorful.Hex(hex)
+	return c
+}
+
+/*
+valueStyle returns the style for a tile of the given value, blending
+colorLow toward colorHigh as game.LogValue(value) approaches
+game.LogValue(goal).
+*/
+func valueStyle(value, goal int) lipgloss.Style {
+	if value == 0 {
+		return cellStyle(emptyCellColor)
+	}
+	t := game.LogValue(value) / game.LogValue(goal)
+	if t > 1 {
+		t = 1
+	}
+	return cellStyle(colorLow.BlendLuv(colorHigh, t).Hex())
+}
+
+// minCellWidth keeps a tile readable (room for "2048" plus padding) even
+// when the board is wider than the terminal.
+const minCellWidth = 6
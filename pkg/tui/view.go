@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func (m Model) View() string {
+	content := m.boardView()
+	if m.finished {
+		content = m.gameOverView()
+	}
+
+	if m.width == 0 || m.height == 0 {
+		return content
+	}
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
+
+/*
+boardView renders the grid as a lipgloss grid of cells, scaling cell
+width to the available terminal width, followed by the score, move
+count, and the help text.
+*/
+func (m Model) boardView() string {
+	size := m.board.Size()
+
+	cellWidth := minCellWidth
+	if m.width > 0 && m.width/size > cellWidth {
+		cellWidth = m.width / size
+	}
+
+	rows := make([]string, size)
+	for y, row := range m.board.Grid() {
+		cells := make([]string, size)
+		for x, value := range row {
+			text := ""
+			if value > 0 {
+				text = strconv.Itoa(value)
+			}
+			cells[x] = valueStyle(value, m.board.Goal()).
+				Width(cellWidth).
+				Align(lipgloss.Center).
+				Render(text)
+		}
+		rows[y] = lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+	}
+	grid := lipgloss.JoinVertical(lipgloss.Left, rows...)
+
+	help := fmt.Sprintf("Score: %d   Moves: %d\n\n", m.score, m.moves)
+	help += "Use your arrow keys or h, j, k, l to move\nthe tiles. "
+	help += "Tiles with the same number merge\ninto one when they touch. "
+	help += fmt.Sprintf("Add them up to\nreach %d!\n", m.board.Goal())
+	help += "Press a to let the AI take over, u to\nundo, ctrl+r to redo.\n"
+	if m.autoplay {
+		help += "Autoplay is ON.\n"
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, grid, "", help)
+}
+
+/*
+gameOverView renders the final score alongside the leaderboard once a
+game is finished, either by reaching the goal tile or by running out of
+moves.
+*/
+func (m Model) gameOverView() string {
+	var s string
+	if m.won {
+		s += fmt.Sprintf("You reached %d!\n\n", m.board.Goal())
+	} else {
+		s += "Game over!\n\n"
+	}
+	s += fmt.Sprintf("Score: %d   Highest tile: %d\n\n", m.score, m.board.HighestTile())
+	s += "Leaderboard:\n"
+	for i, entry := range m.leaderboard {
+		s += fmt.Sprintf("%2d. %6d pts  tile %-5d  %s\n", i+1, entry.Score, entry.HighestTile, entry.Duration.Round(time.Second))
+	}
+	s += "\nPress n for a new game, q to quit.\n"
+	return s
+}